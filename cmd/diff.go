@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ksoclabs/kbom/internal/diff"
+	"github.com/ksoclabs/kbom/internal/model"
+	"github.com/ksoclabs/kbom/internal/utils"
+)
+
+const (
+	DiffTextOutput     = "text"
+	DiffJSONOutput     = "json"
+	DiffMarkdownOutput = "markdown"
+)
+
+var (
+	diffOutput string
+	diffFormat string
+)
+
+var DiffCmd = &cobra.Command{
+	Use:   "diff <from> <to>",
+	Short: "Diff two KBOMs",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runDiff,
+}
+
+func init() {
+	DiffCmd.Flags().StringVarP(&diffOutput, "output", "o", DiffTextOutput, "Output (text, json, markdown)")
+	DiffCmd.Flags().StringVarP(&diffFormat, "format", "f", "", "Format of the input KBOMs, auto-detected from file extension if unset (json, yaml)")
+
+	utils.BindFlags(DiffCmd)
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	from, err := loadKBOM(args[0])
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", args[0], err)
+	}
+
+	to, err := loadKBOM(args[1])
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", args[1], err)
+	}
+
+	report := diff.Compute(from, to)
+
+	switch diffOutput {
+	case DiffTextOutput:
+		return diff.RenderText(os.Stdout, report)
+	case DiffJSONOutput:
+		return diff.RenderJSON(os.Stdout, report)
+	case DiffMarkdownOutput:
+		return diff.RenderMarkdown(os.Stdout, report)
+	default:
+		return fmt.Errorf("output %q is not supported", diffOutput)
+	}
+}
+
+// loadKBOM reads and decodes a KBOM previously written by `kbom generate`.
+// The format is taken from --format, falling back to the file extension.
+func loadKBOM(p string) (*model.KBOM, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+
+	f := diffFormat
+	if f == "" {
+		switch strings.ToLower(filepath.Ext(p)) {
+		case ".yaml", ".yml":
+			f = YAMLFormat
+		case ".json":
+			f = JSONFormat
+		default:
+			return nil, fmt.Errorf("cannot auto-detect format of %s from its extension, pass --format", p)
+		}
+	}
+
+	var kbom model.KBOM
+	switch f {
+	case YAMLFormat:
+		if err := yaml.Unmarshal(data, &kbom); err != nil {
+			return nil, err
+		}
+	case JSONFormat:
+		if err := json.Unmarshal(data, &kbom); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("format %q is not supported for diff input", f)
+	}
+
+	return &kbom, nil
+}
@@ -6,13 +6,19 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 	"gopkg.in/yaml.v2"
 
+	"github.com/ksoclabs/kbom/internal/attest"
+	"github.com/ksoclabs/kbom/internal/bundle"
 	"github.com/ksoclabs/kbom/internal/config"
+	"github.com/ksoclabs/kbom/internal/format"
 	"github.com/ksoclabs/kbom/internal/kube"
 	"github.com/ksoclabs/kbom/internal/model"
 	"github.com/ksoclabs/kbom/internal/utils"
@@ -23,18 +29,37 @@ const (
 	BOMFormat   = "ksoc"
 	SpecVersion = "0.1"
 
-	StdOutput  = "stdout"
-	FileOutput = "file"
+	StdOutput    = "stdout"
+	FileOutput   = "file"
+	BundleOutput = "bundle"
 
-	JSONFormat = "json"
-	YAMLFormat = "yaml"
+	JSONFormat          = "json"
+	YAMLFormat          = "yaml"
+	CycloneDXJSONFormat = "cyclonedx-json"
+	CycloneDXXMLFormat  = "cyclonedx-xml"
+	SPDXJSONFormat      = "spdx-json"
+
+	IndexFileName = "index"
+
+	ZipBundleFormat   = "zip"
+	TarGzBundleFormat = "tar.gz"
+
+	IntotoExt = ".intoto.jsonl"
 )
 
 var (
-	short   bool
-	output  string
-	format  string
-	outPath string
+	short        bool
+	output       string
+	outputFormat string
+	outPath      string
+	contexts     []string
+	allContexts  bool
+	bundlePath   string
+	bundleFormat string
+	verbose      bool
+	sign         bool
+	keyPath      string
+	keyless      bool
 )
 
 var GenerateCmd = &cobra.Command{
@@ -46,8 +71,19 @@ var GenerateCmd = &cobra.Command{
 func init() {
 	GenerateCmd.Flags().BoolVar(&short, "short", false, "Short - only include metadata, nodes, images and resources counters")
 	GenerateCmd.Flags().StringVarP(&output, "output", "o", StdOutput, "Output (stdout, file)")
-	GenerateCmd.Flags().StringVarP(&format, "format", "f", JSONFormat, "Format (json, yaml)")
+	GenerateCmd.Flags().StringVarP(&outputFormat, "format", "f", JSONFormat, "Format (json, yaml, cyclonedx-json, cyclonedx-xml, spdx-json)")
 	GenerateCmd.Flags().StringVarP(&outPath, "out-path", "p", ".", "Path to write KBOM to")
+	GenerateCmd.Flags().StringSliceVar(&contexts, "contexts", nil, "Comma-separated list of kubeconfig contexts to generate a KBOM for")
+	GenerateCmd.Flags().BoolVar(&allContexts, "all-contexts", false, "Generate a KBOM for every context in the kubeconfig")
+	GenerateCmd.Flags().StringVar(&bundlePath, "bundle-path", "", "Path to write the support bundle to (used with --output=bundle, defaults alongside --out-path)")
+	GenerateCmd.Flags().StringVar(&bundleFormat, "bundle-format", ZipBundleFormat, "Support bundle archive format (zip, tar.gz)")
+	GenerateCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Print collector progress while assembling a support bundle")
+	GenerateCmd.Flags().BoolVar(&sign, "sign", false, "Sign the KBOM and write an in-toto/DSSE envelope alongside it (requires --output=file or --output=bundle)")
+	GenerateCmd.Flags().StringVar(&keyPath, "key", "", "Path to an ed25519/ECDSA PEM private key to sign with")
+	GenerateCmd.Flags().BoolVar(&keyless, "keyless", false, "Sign keylessly via Fulcio/OIDC instead of --key")
+	// --keyless is hidden from help: KeylessSigner makes no Fulcio/Rekor
+	// request and always returns an error.
+	_ = GenerateCmd.Flags().MarkHidden("keyless")
 
 	utils.BindFlags(GenerateCmd)
 }
@@ -55,35 +91,289 @@ func init() {
 func runGenerate(cmd *cobra.Command, _ []string) error {
 	ctx := context.Background()
 
-	k8sClient, err := kube.NewClient()
+	if allContexts || len(contexts) > 0 {
+		return runGenerateMulti(ctx)
+	}
+
+	if output == BundleOutput {
+		return runGenerateBundle(ctx, "")
+	}
+
+	kbom, err := generateForContext(ctx, "")
 	if err != nil {
 		return err
 	}
 
-	k8sVersion, caCertDigest, err := k8sClient.Metadata(ctx)
+	p, err := printKBOM(kbom, "")
+	if err != nil {
+		return err
+	}
+
+	return maybeSign(ctx, kbom, p)
+}
+
+// maybeSign wraps kbom in a signed in-toto attestation when --sign or
+// --keyless was passed, writing the DSSE envelope to kbomPath+".intoto.jsonl".
+func maybeSign(ctx context.Context, kbom *model.KBOM, kbomPath string) error {
+	if !sign && !keyless {
+		return nil
+	}
+
+	if kbomPath == "" {
+		return fmt.Errorf("--sign/--keyless require --output=file or --output=bundle")
+	}
+
+	data, err := signEnvelope(ctx, kbom)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(kbomPath+IntotoExt, data, 0o644)
+}
+
+// signEnvelope wraps kbom in a signed in-toto attestation and returns the
+// marshalled DSSE envelope, newline-terminated as one JSON Lines record.
+func signEnvelope(ctx context.Context, kbom *model.KBOM) ([]byte, error) {
+	signer, err := newSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := attest.Sign(ctx, attest.NewStatement(kbom), signer)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(envelope)
 	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+func newSigner() (attest.Signer, error) {
+	switch {
+	case keyless:
+		return &attest.KeylessSigner{}, nil
+	case keyPath != "":
+		return attest.LoadSigner(keyPath)
+	default:
+		return nil, fmt.Errorf("--sign requires --key or --keyless")
+	}
+}
+
+// runGenerateBundle collects the rendered KBOM plus the raw evidence it was
+// derived from into a single support-bundle archive.
+func runGenerateBundle(ctx context.Context, kubeContext string) error {
+	k8sClient, err := kube.NewClient(kubeContext)
+	if err != nil {
+		return err
+	}
+
+	kbom, err := kbomFromClient(ctx, k8sClient)
+	if err != nil {
+		return err
+	}
+
+	p := bundlePath
+	if p == "" {
+		formattedTime := kbom.GeneratedAt.Format("2006-01-02-15-04-05")
+		key := kbom.ID[:8]
+		if len(kbom.Cluster.CACertDigest) > 8 {
+			key = kbom.Cluster.CACertDigest[:8]
+		}
+		p = path.Join(outPath, fmt.Sprintf("kbom-%s-%s.%s", key, formattedTime, bundleFormat))
+	}
+
+	f, err := os.Create(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var archive bundle.Archive
+	var closeArchive func() error
+	switch bundleFormat {
+	case TarGzBundleFormat:
+		archive, closeArchive = bundle.NewTarGzArchive(f)
+	default:
+		archive, closeArchive = bundle.NewZipArchive(f)
+	}
+
+	collectors := []bundle.Collector{
+		&bundle.KBOMCollector{KBOM: kbom},
+		&bundle.MetadataCollector{Client: k8sClient},
+		&bundle.NodesCollector{Client: k8sClient},
+		&bundle.ImagesCollector{Client: k8sClient},
+		&bundle.ResourcesCollector{Client: k8sClient},
+	}
+
+	var progress chan bundle.Progress
+	if verbose {
+		progress = make(chan bundle.Progress)
+		go reportProgress(progress)
+	}
+
+	if err := bundle.Run(ctx, archive, collectors, progress); err != nil {
+		return err
+	}
+
+	if sign || keyless {
+		data, err := signEnvelope(ctx, kbom)
+		if err != nil {
+			return err
+		}
+
+		if _, err := archive.WriteFile("kbom.json"+IntotoExt, data); err != nil {
+			return err
+		}
+	}
+
+	return closeArchive()
+}
+
+func reportProgress(progress <-chan bundle.Progress) {
+	for p := range progress {
+		if p.Err != nil {
+			fmt.Fprintf(os.Stderr, "✗ %s: %v\n", p.Collector, p.Err)
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "✓ %s\n", p.Collector)
+	}
+}
+
+// runGenerateMulti generates a KBOM per kubeconfig context concurrently, then
+// emits an aggregated document summarizing all of them.
+func runGenerateMulti(ctx context.Context) error {
+	ctxNames := contexts
+	if allContexts {
+		all, err := kube.Contexts()
+		if err != nil {
+			return err
+		}
+		ctxNames = all
+	}
+
+	if len(ctxNames) == 0 {
+		return fmt.Errorf("no kubeconfig contexts to generate a KBOM for")
+	}
+
+	if output == BundleOutput {
+		return fmt.Errorf("--output=bundle is not supported with --contexts/--all-contexts; run `generate --output=bundle` once per context instead")
+	}
+
+	kboms := make([]*model.KBOM, len(ctxNames))
+
+	g, gCtx := errgroup.WithContext(ctx)
+	var mu sync.Mutex
+	for i, ctxName := range ctxNames {
+		i, ctxName := i, ctxName
+		g.Go(func() error {
+			kbom, err := generateForContext(gCtx, ctxName)
+			if err != nil {
+				return fmt.Errorf("context %q: %w", ctxName, err)
+			}
+
+			mu.Lock()
+			kboms[i] = kbom
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
 		return err
 	}
 
+	agg := model.AggregateKBOM{
+		ID:          uuid.New().String(),
+		BOMFormat:   BOMFormat,
+		SpecVersion: SpecVersion,
+		GeneratedAt: time.Now(),
+		GeneratedBy: generatedBy(),
+	}
+
+	for i, kbom := range kboms {
+		ref := model.ClusterRef{
+			Context:        ctxNames[i],
+			ID:             kbom.ID,
+			K8sVersion:     kbom.Cluster.K8sVersion,
+			CACertDigest:   kbom.Cluster.CACertDigest,
+			NodesCount:     kbom.Cluster.NodesCount,
+			ResourcesCount: len(kbom.Cluster.Resources.Resources),
+			ImagesCount:    len(kbom.Cluster.Resources.Images),
+		}
+
+		if output == FileOutput {
+			p, err := printKBOM(kbom, sanitizeFileKey(ctxNames[i]))
+			if err != nil {
+				return err
+			}
+			if err := maybeSign(ctx, kbom, p); err != nil {
+				return err
+			}
+			ref.Path = p
+		}
+
+		agg.Clusters = append(agg.Clusters, ref)
+	}
+
+	if output == FileOutput {
+		return writeIndex(&agg)
+	}
+
+	return printAggregate(&agg)
+}
+
+func generatedBy() model.Tool {
+	return model.Tool{
+		Vendor:     KSOCCompany,
+		BuildTime:  config.BuildTime,
+		Name:       config.AppName,
+		Version:    config.AppVersion,
+		Commit:     config.LastCommitHash,
+		CommitTime: config.LastCommitTime,
+	}
+}
+
+// generateForContext produces a single cluster's KBOM. An empty kubeContext
+// uses the kubeconfig's current context.
+func generateForContext(ctx context.Context, kubeContext string) (*model.KBOM, error) {
+	k8sClient, err := kube.NewClient(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return kbomFromClient(ctx, k8sClient)
+}
+
+func kbomFromClient(ctx context.Context, k8sClient kube.Client) (*model.KBOM, error) {
+	k8sVersion, caCertDigest, err := k8sClient.Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	full := !short
 	nodes, err := k8sClient.AllNodes(ctx, full)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	loc, err := k8sClient.Location(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	allImages, err := k8sClient.AllImages(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resources, err := k8sClient.AllResources(ctx, full)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	kbom := model.KBOM{
@@ -91,14 +381,7 @@ func runGenerate(cmd *cobra.Command, _ []string) error {
 		BOMFormat:   BOMFormat,
 		SpecVersion: SpecVersion,
 		GeneratedAt: time.Now(),
-		GeneratedBy: model.Tool{
-			Vendor:     KSOCCompany,
-			BuildTime:  config.BuildTime,
-			Name:       config.AppName,
-			Version:    config.AppVersion,
-			Commit:     config.LastCommitHash,
-			CommitTime: config.LastCommitTime,
-		},
+		GeneratedBy: generatedBy(),
 		Cluster: model.Cluster{
 			Location:     *loc,
 			CNIVersion:   "", // TODO: get CNI version
@@ -113,57 +396,103 @@ func runGenerate(cmd *cobra.Command, _ []string) error {
 		},
 	}
 
-	if err := printKBOM(&kbom); err != nil {
-		return err
-	}
-
-	return nil
+	return &kbom, nil
 }
 
-func printKBOM(kbom *model.KBOM) error {
-	writer, err := getWriter(kbom)
+// printKBOM writes kbom according to the --output/--format flags. When
+// writing to a file, it returns the path it wrote to. key, if non-empty,
+// overrides the default ID/CA-digest derived file key (used to name
+// per-cluster files by context when generating for multiple contexts).
+func printKBOM(kbom *model.KBOM, key string) (string, error) {
+	formatter, ok := format.Get(outputFormat)
+	if !ok {
+		return "", fmt.Errorf("format %q is not supported", outputFormat)
+	}
+
+	writer, p, err := getWriter(kbom, key)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer writer.Close()
 
-	switch format {
+	if err := formatter.Encode(writer, kbom); err != nil {
+		return "", err
+	}
+
+	return p, nil
+}
+
+// printAggregate and writeIndex always use the ksoc shape: CycloneDX/SPDX
+// have no representation for a fleet-level summary document.
+func printAggregate(agg *model.AggregateKBOM) error {
+	return encodeAggregate(os.Stdout, agg)
+}
+
+func encodeAggregate(w *os.File, agg *model.AggregateKBOM) error {
+	switch outputFormat {
 	case JSONFormat:
-		enc := json.NewEncoder(writer)
+		enc := json.NewEncoder(w)
 		enc.SetIndent("", "  ")
-		if err := enc.Encode(kbom); err != nil {
-			return err
-		}
+		return enc.Encode(agg)
 	case YAMLFormat:
-		enc := yaml.NewEncoder(writer)
-		if err := enc.Encode(kbom); err != nil {
-			return err
-		}
+		return yaml.NewEncoder(w).Encode(agg)
 	default:
-		return fmt.Errorf("format %q is not supported", format)
+		return fmt.Errorf("format %q does not support aggregated documents, use json or yaml", outputFormat)
 	}
+}
 
-	return nil
+// sanitizeFileKey makes s safe to use as a single path component in a
+// generated KBOM file name. Kubeconfig context names commonly contain "/"
+// and ":" (e.g. EKS cluster ARNs used as context names), which would
+// otherwise produce bogus nested paths or fail os.Create outright.
+func sanitizeFileKey(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+
+	return b.String()
 }
 
-func getWriter(kbom *model.KBOM) (*os.File, error) {
+func getWriter(kbom *model.KBOM, key string) (*os.File, string, error) {
 	switch output {
 	case StdOutput:
-		return os.Stdout, nil
+		return os.Stdout, "", nil
 	case FileOutput:
 		formattedTime := kbom.GeneratedAt.Format("2006-01-02-15-04-05")
-		key := kbom.ID[:8]
-		if len(kbom.Cluster.CACertDigest) > 8 {
-			key = kbom.Cluster.CACertDigest[:8]
+		if key == "" {
+			key = kbom.ID[:8]
+			if len(kbom.Cluster.CACertDigest) > 8 {
+				key = kbom.Cluster.CACertDigest[:8]
+			}
 		}
 
-		f, err := os.Create(path.Join(outPath, fmt.Sprintf("kbom-%s-%s.%s", key, formattedTime, format)))
+		p := path.Join(outPath, fmt.Sprintf("kbom-%s-%s.%s", key, formattedTime, outputFormat))
+		f, err := os.Create(p)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
-		return f, nil
+		return f, p, nil
 	default:
-		return nil, fmt.Errorf("output %q is not supported", output)
+		return nil, "", fmt.Errorf("output %q is not supported", output)
 	}
-}
\ No newline at end of file
+}
+
+// writeIndex writes the aggregated manifest describing every per-cluster
+// KBOM file produced by a multi-context run.
+func writeIndex(agg *model.AggregateKBOM) error {
+	p := path.Join(outPath, fmt.Sprintf("%s.%s", IndexFileName, outputFormat))
+	f, err := os.Create(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return encodeAggregate(f, agg)
+}
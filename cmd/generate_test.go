@@ -0,0 +1,23 @@
+package cmd
+
+import "testing"
+
+func TestSanitizeFileKey(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain name", "staging", "staging"},
+		{"eks arn", "arn:aws:eks:us-west-2:123456789012:cluster/prod", "arn-aws-eks-us-west-2-123456789012-cluster-prod"},
+		{"already safe", "my-cluster_01.local", "my-cluster_01.local"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeFileKey(tt.in); got != tt.want {
+				t.Errorf("sanitizeFileKey(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+// RootCmd is the entry point for the kbom CLI.
+var RootCmd = &cobra.Command{
+	Use:   "kbom",
+	Short: "Generate, diff and verify Kubernetes Bill of Materials (KBOM) documents",
+}
+
+func init() {
+	RootCmd.AddCommand(GenerateCmd)
+	RootCmd.AddCommand(DiffCmd)
+	RootCmd.AddCommand(VerifyCmd)
+}
+
+// Execute runs the CLI, returning any error from the invoked subcommand.
+func Execute() error {
+	return RootCmd.Execute()
+}
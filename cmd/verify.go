@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ksoclabs/kbom/internal/attest"
+	"github.com/ksoclabs/kbom/internal/utils"
+)
+
+var verifyKeyPath string
+var verifyKeyless bool
+
+var VerifyCmd = &cobra.Command{
+	Use:   "verify <envelope>",
+	Short: "Verify a signed KBOM's in-toto/DSSE envelope and print the KBOM it attests to",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runVerify,
+}
+
+func init() {
+	VerifyCmd.Flags().StringVar(&verifyKeyPath, "key", "", "Path to the ed25519/ECDSA PEM public key to verify against")
+	VerifyCmd.Flags().BoolVar(&verifyKeyless, "keyless", false, "Verify against the Rekor transparency log instead of --key")
+	// --keyless is hidden from help: RekorVerifier makes no Rekor lookup
+	// and always returns an error.
+	_ = VerifyCmd.Flags().MarkHidden("keyless")
+
+	utils.BindFlags(VerifyCmd)
+}
+
+func runVerify(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	var envelope attest.Envelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+
+	verifier, err := newVerifier()
+	if err != nil {
+		return err
+	}
+
+	statement, err := attest.Verify(ctx, &envelope, verifier)
+	if err != nil {
+		return fmt.Errorf("verification failed: %w", err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(statement.Predicate)
+}
+
+func newVerifier() (attest.Verifier, error) {
+	switch {
+	case verifyKeyless:
+		return &attest.RekorVerifier{}, nil
+	case verifyKeyPath != "":
+		return attest.LoadVerifier(verifyKeyPath)
+	default:
+		return nil, fmt.Errorf("verify requires --key or --keyless")
+	}
+}
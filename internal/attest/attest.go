@@ -0,0 +1,43 @@
+// Package attest wraps a KBOM in a signed in-toto attestation, so
+// downstream consumers can trust that a KBOM was produced by a specific
+// pipeline against a specific cluster before feeding it into policy
+// decisions.
+package attest
+
+import "github.com/ksoclabs/kbom/internal/model"
+
+const (
+	// PredicateType identifies a KBOM as an in-toto attestation predicate.
+	PredicateType = "https://ksoc.com/kbom/v0.1"
+	// StatementType is the in-toto Statement schema version KBOM attests under.
+	StatementType = "https://in-toto.io/Statement/v0.1"
+)
+
+// Statement is an in-toto Statement whose predicate is a full KBOM. The
+// cluster's CA certificate digest is recorded as the subject's attestable
+// material.
+type Statement struct {
+	Type          string      `json:"_type"`
+	PredicateType string      `json:"predicateType"`
+	Subject       []Subject   `json:"subject"`
+	Predicate     *model.KBOM `json:"predicate"`
+}
+
+// Subject identifies the cluster a Statement's predicate describes.
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// NewStatement wraps kbom in an in-toto Statement.
+func NewStatement(kbom *model.KBOM) *Statement {
+	return &Statement{
+		Type:          StatementType,
+		PredicateType: PredicateType,
+		Subject: []Subject{{
+			Name:   "cluster/" + kbom.ID,
+			Digest: map[string]string{"sha256": kbom.Cluster.CACertDigest},
+		}},
+		Predicate: kbom,
+	}
+}
@@ -0,0 +1,95 @@
+package attest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// PayloadType is the DSSE payload type for an in-toto Statement.
+const PayloadType = "application/vnd.in-toto+json"
+
+// Envelope is a DSSE (Dead Simple Signing Envelope) wrapping a signed
+// payload. See https://github.com/secure-systems-lab/dsse.
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     string      `json:"payload"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+// Signature is one signature over an Envelope's payload.
+type Signature struct {
+	KeyID string `json:"keyid,omitempty"`
+	Sig   string `json:"sig"`
+}
+
+// Signer produces a signature over an arbitrary payload. KeySigner
+// (key.go) signs with a plain ed25519/ECDSA key file and backs
+// `generate --sign --key`; KeylessSigner (keyless.go) makes no Fulcio/Rekor
+// request and always returns an error.
+type Signer interface {
+	Sign(ctx context.Context, payload []byte) (sig []byte, keyID string, err error)
+}
+
+// Verifier checks a signature produced by a Signer.
+type Verifier interface {
+	Verify(ctx context.Context, payload, sig []byte, keyID string) error
+}
+
+// Sign wraps statement in a DSSE envelope signed by signer.
+func Sign(ctx context.Context, statement *Statement, signer Signer) (*Envelope, error) {
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, keyID, err := signer.Sign(ctx, pae(PayloadType, payload))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		PayloadType: PayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []Signature{{KeyID: keyID, Sig: base64.StdEncoding.EncodeToString(sig)}},
+	}, nil
+}
+
+// Verify checks env's signatures against verifier and, once one is valid,
+// decodes and returns the wrapped Statement.
+func Verify(ctx context.Context, env *Envelope, verifier Verifier) (*Statement, error) {
+	payload, err := base64.StdEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var verified bool
+	for _, s := range env.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			continue
+		}
+		if err := verifier.Verify(ctx, pae(env.PayloadType, payload), sig, s.KeyID); err == nil {
+			verified = true
+			break
+		}
+	}
+
+	if !verified {
+		return nil, fmt.Errorf("no valid signature found in envelope")
+	}
+
+	var statement Statement
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return nil, err
+	}
+
+	return &statement, nil
+}
+
+// pae implements the DSSE Pre-Authentication Encoding, which is what gets
+// signed rather than the raw payload bytes.
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
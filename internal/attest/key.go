@@ -0,0 +1,126 @@
+package attest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+)
+
+// KeySigner signs with a plain ed25519 or ECDSA private key loaded from a
+// PEM file, as opposed to the keyless (Fulcio/OIDC) flow in keyless.go.
+type KeySigner struct {
+	keyID string
+	key   crypto.Signer
+}
+
+// LoadSigner reads an unencrypted PKCS#8 PEM private key (ed25519 or
+// ECDSA) from path.
+func LoadSigner(path string) (*KeySigner, error) {
+	key, err := loadPrivateKey(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeySigner{keyID: keyID(key.Public()), key: key}, nil
+}
+
+func (s *KeySigner) Sign(_ context.Context, payload []byte) ([]byte, string, error) {
+	switch k := s.key.(type) {
+	case ed25519.PrivateKey:
+		return ed25519.Sign(k, payload), s.keyID, nil
+	default:
+		digest := sha256.Sum256(payload)
+		sig, err := s.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+		if err != nil {
+			return nil, "", err
+		}
+		return sig, s.keyID, nil
+	}
+}
+
+// KeyVerifier verifies signatures produced by a KeySigner, or any
+// ed25519/ECDSA public key loaded from a PEM file.
+type KeyVerifier struct {
+	pub crypto.PublicKey
+}
+
+// LoadVerifier reads a PEM-encoded public key from path.
+func LoadVerifier(path string) (*KeyVerifier, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: not a PEM-encoded key", path)
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyVerifier{pub: pub}, nil
+}
+
+func (v *KeyVerifier) Verify(_ context.Context, payload, sig []byte, _ string) error {
+	switch k := v.pub.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, sig) {
+			return fmt.Errorf("ed25519 signature verification failed")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(payload)
+		if !ecdsa.VerifyASN1(k, digest[:], sig) {
+			return fmt.Errorf("ecdsa signature verification failed")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", k)
+	}
+}
+
+func loadPrivateKey(path string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: not a PEM-encoded key", path)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s: key type %T does not support signing", path, key)
+	}
+
+	return signer, nil
+}
+
+// keyID derives a short, stable identifier for a public key so an
+// Envelope's signatures can be matched back to the key that produced them.
+func keyID(pub crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8])
+}
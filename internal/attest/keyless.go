@@ -0,0 +1,92 @@
+package attest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+)
+
+// KeylessSigner holds the settings for cosign-style keyless signing: a
+// short-lived Fulcio certificate over an ephemeral key, and a Rekor
+// transparency-log entry for the signature. Sign generates the ephemeral
+// ed25519 key and then calls requestFulcioCert, which always returns an
+// error: no Fulcio or Rekor request is made and Sign never returns a
+// signature.
+type KeylessSigner struct {
+	FulcioURL string
+	RekorURL  string
+	Issuer    string
+}
+
+func (s *KeylessSigner) Sign(ctx context.Context, payload []byte) ([]byte, string, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cert, err := requestFulcioCert(ctx, s.FulcioURL, s.Issuer, pub)
+	if err != nil {
+		return nil, "", fmt.Errorf("fulcio: %w", err)
+	}
+
+	sig := ed25519.Sign(priv, payload)
+
+	if err := uploadRekorEntry(ctx, s.RekorURL, cert, sig, payload); err != nil {
+		return nil, "", fmt.Errorf("rekor: %w", err)
+	}
+
+	return sig, cert.fingerprint, nil
+}
+
+// RekorVerifier is the Verifier for keyless signatures, checked against a
+// Rekor transparency log rather than a local public key. Verify calls
+// fetchRekorEntry, which always returns an error: no Rekor lookup is made
+// and Verify never accepts a signature.
+type RekorVerifier struct {
+	RekorURL string
+}
+
+func (v *RekorVerifier) Verify(ctx context.Context, payload, sig []byte, keyID string) error {
+	entry, err := fetchRekorEntry(ctx, v.RekorURL, keyID)
+	if err != nil {
+		return fmt.Errorf("rekor: %w", err)
+	}
+
+	return entry.verify(payload, sig)
+}
+
+type fulcioCert struct {
+	fingerprint string
+}
+
+// requestFulcioCert exchanges an OIDC identity token for a Fulcio
+// code-signing certificate over the ephemeral public key.
+//
+// TODO: wire up the actual OIDC/Fulcio exchange; --keyless is not usable
+// until this lands.
+func requestFulcioCert(_ context.Context, _, _ string, _ ed25519.PublicKey) (*fulcioCert, error) {
+	return nil, fmt.Errorf("keyless signing is not wired up yet, use --key instead")
+}
+
+// uploadRekorEntry records a signed payload in the Rekor transparency log.
+//
+// TODO: wire up the actual Rekor upload; --keyless is not usable until
+// this lands.
+func uploadRekorEntry(_ context.Context, _ string, _ *fulcioCert, _, _ []byte) error {
+	return fmt.Errorf("keyless signing is not wired up yet, use --key instead")
+}
+
+type rekorEntry struct{}
+
+func (rekorEntry) verify(_, _ []byte) error {
+	return fmt.Errorf("rekor-backed verification is not wired up yet, use --key instead")
+}
+
+// fetchRekorEntry looks up a Rekor transparency-log entry by key ID.
+//
+// TODO: wire up the actual Rekor lookup; keyless verification is not
+// usable until this lands.
+func fetchRekorEntry(_ context.Context, _, _ string) (*rekorEntry, error) {
+	return nil, fmt.Errorf("rekor-backed verification is not wired up yet, use --key instead")
+}
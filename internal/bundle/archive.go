@@ -0,0 +1,89 @@
+package bundle
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// zipArchive is an Archive backed by archive/zip. Writes are serialized
+// since collectors may run concurrently but the underlying zip.Writer isn't
+// safe for concurrent use.
+type zipArchive struct {
+	mu sync.Mutex
+	zw *zip.Writer
+}
+
+// NewZipArchive returns an Archive that writes a .zip to w.
+func NewZipArchive(w io.Writer) (Archive, func() error) {
+	zw := zip.NewWriter(w)
+	return &zipArchive{zw: zw}, zw.Close
+}
+
+func (a *zipArchive) WriteFile(name string, data []byte) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	f, err := a.zw.Create(name)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		return "", err
+	}
+
+	return digest(data), nil
+}
+
+// tarGzArchive is an Archive backed by archive/tar wrapped in gzip.
+type tarGzArchive struct {
+	mu sync.Mutex
+	gw *gzip.Writer
+	tw *tar.Writer
+}
+
+// NewTarGzArchive returns an Archive that writes a .tar.gz to w.
+func NewTarGzArchive(w io.Writer) (Archive, func() error) {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	a := &tarGzArchive{gw: gw, tw: tw}
+
+	return a, func() error {
+		if err := tw.Close(); err != nil {
+			return err
+		}
+		return gw.Close()
+	}
+}
+
+func (a *tarGzArchive) WriteFile(name string, data []byte) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	hdr := &tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(data)),
+	}
+
+	if err := a.tw.WriteHeader(hdr); err != nil {
+		return "", err
+	}
+
+	if _, err := a.tw.Write(data); err != nil {
+		return "", err
+	}
+
+	return digest(data), nil
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("sha256:%s", hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,83 @@
+package bundle
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+)
+
+func TestZipArchiveRoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	archive, closeArchive := NewZipArchive(buf)
+
+	d, err := archive.WriteFile("kbom.json", []byte(`{"id":"abc"}`))
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if d != digest([]byte(`{"id":"abc"}`)) {
+		t.Errorf("WriteFile returned digest %q, want %q", d, digest([]byte(`{"id":"abc"}`)))
+	}
+	if err := closeArchive(); err != nil {
+		t.Fatalf("closeArchive: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+	if len(zr.File) != 1 || zr.File[0].Name != "kbom.json" {
+		t.Fatalf("unexpected zip contents: %+v", zr.File)
+	}
+
+	rc, err := zr.File[0].Open()
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer rc.Close()
+
+	got, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != `{"id":"abc"}` {
+		t.Errorf("got %q, want %q", got, `{"id":"abc"}`)
+	}
+}
+
+func TestTarGzArchiveRoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	archive, closeArchive := NewTarGzArchive(buf)
+
+	if _, err := archive.WriteFile("nodes/node-1.yaml", []byte("name: node-1\n")); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if err := closeArchive(); err != nil {
+		t.Fatalf("closeArchive: %v", err)
+	}
+
+	gr, err := gzip.NewReader(buf)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	hdr, err := tr.Next()
+	if err != nil {
+		t.Fatalf("tr.Next: %v", err)
+	}
+	if hdr.Name != "nodes/node-1.yaml" {
+		t.Errorf("got name %q, want %q", hdr.Name, "nodes/node-1.yaml")
+	}
+
+	got, err := io.ReadAll(tr)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != "name: node-1\n" {
+		t.Errorf("got %q, want %q", got, "name: node-1\n")
+	}
+}
@@ -0,0 +1,30 @@
+// Package bundle assembles a support-bundle archive: the rendered KBOM plus
+// the raw evidence it was derived from, so an auditor can verify the KBOM's
+// provenance instead of trusting a JSON blob on its own.
+package bundle
+
+import "context"
+
+// Progress reports a single collector's status as a bundle is assembled.
+type Progress struct {
+	Collector string
+	Done      bool
+	Err       error
+}
+
+// Archive is the write target a Collector streams its evidence into. It
+// abstracts over the underlying archive format (zip, tar.gz) so collectors
+// don't need to know which one is in use.
+type Archive interface {
+	// WriteFile adds a file entry under name and returns its SHA-256 digest
+	// so it can be recorded in the manifest.
+	WriteFile(name string, data []byte) (digest string, err error)
+}
+
+// Collector gathers one kind of evidence (nodes, images, resources, cluster
+// metadata, ...) and writes it into the archive.
+type Collector interface {
+	// Name identifies the collector in progress reports and the manifest.
+	Name() string
+	Collect(ctx context.Context, archive Archive) error
+}
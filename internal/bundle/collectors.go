@@ -0,0 +1,172 @@
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/ksoclabs/kbom/internal/kube"
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+// KBOMCollector writes the KBOM into the bundle as indented JSON
+// (kbom.json), so consumers get the summary alongside the raw evidence it
+// was derived from.
+type KBOMCollector struct {
+	KBOM *model.KBOM
+}
+
+func (c *KBOMCollector) Name() string { return "kbom" }
+
+func (c *KBOMCollector) Collect(_ context.Context, archive Archive) error {
+	data, err := json.MarshalIndent(c.KBOM, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = archive.WriteFile("kbom.json", data)
+	return err
+}
+
+// NodesCollector dumps every cluster node as its own YAML file.
+type NodesCollector struct {
+	Client kube.Client
+}
+
+func (c *NodesCollector) Name() string { return "nodes" }
+
+func (c *NodesCollector) Collect(ctx context.Context, archive Archive) error {
+	nodes, err := c.Client.NodeObjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range nodes {
+		data, err := yaml.Marshal(n)
+		if err != nil {
+			return err
+		}
+
+		if _, err := archive.WriteFile(fmt.Sprintf("nodes/%s.yaml", n.GetName()), data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImagesCollector writes out the flat list of container images discovered
+// in the cluster.
+type ImagesCollector struct {
+	Client kube.Client
+}
+
+func (c *ImagesCollector) Name() string { return "images" }
+
+func (c *ImagesCollector) Collect(ctx context.Context, archive Archive) error {
+	images, err := c.Client.AllImages(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(images, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = archive.WriteFile("images.json", data)
+	return err
+}
+
+// ResourcesCollector writes the full resource inventory, one file per GVK,
+// plus the API resource list the cluster advertised.
+type ResourcesCollector struct {
+	Client kube.Client
+}
+
+func (c *ResourcesCollector) Name() string { return "resources" }
+
+func (c *ResourcesCollector) Collect(ctx context.Context, archive Archive) error {
+	byGVK, err := c.Client.ResourcesByGVK(ctx)
+	if err != nil {
+		return err
+	}
+
+	for gvk, objs := range byGVK {
+		data, err := yaml.Marshal(objs)
+		if err != nil {
+			return err
+		}
+
+		if _, err := archive.WriteFile(fmt.Sprintf("resources/%s.yaml", sanitizeFilename(gvk)), data); err != nil {
+			return err
+		}
+	}
+
+	apiResources, err := c.Client.APIResourceList(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(apiResources, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = archive.WriteFile("api-resources.json", data)
+	return err
+}
+
+// sanitizeFilename makes s safe to use as a single path component in a
+// bundle entry name. GVK strings contain "/" (e.g. "apps/v1, Kind=Deployment")
+// which archive/zip and archive/tar both interpret as a path separator,
+// nesting the entry under a spurious subdirectory instead of producing the
+// intended one-file-per-GVK layout.
+func sanitizeFilename(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+
+	return b.String()
+}
+
+// MetadataCollector records cluster-level metadata (K8s version, CA digest,
+// location) that isn't tied to a specific resource kind.
+type MetadataCollector struct {
+	Client kube.Client
+}
+
+func (c *MetadataCollector) Name() string { return "cluster-metadata" }
+
+func (c *MetadataCollector) Collect(ctx context.Context, archive Archive) error {
+	k8sVersion, caCertDigest, err := c.Client.Metadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	loc, err := c.Client.Location(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(struct {
+		K8sVersion   string         `json:"k8sVersion"`
+		CACertDigest string         `json:"caCertDigest"`
+		Location     model.Location `json:"location"`
+	}{k8sVersion, caCertDigest, *loc}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = archive.WriteFile("cluster-metadata.json", data)
+	return err
+}
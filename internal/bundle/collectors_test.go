@@ -0,0 +1,23 @@
+package bundle
+
+import "testing"
+
+func TestSanitizeFilename(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"core gvk", "/v1, Kind=Pod", "-v1--Kind-Pod"},
+		{"group gvk", "apps/v1, Kind=Deployment", "apps-v1--Kind-Deployment"},
+		{"already safe", "nodes-1", "nodes-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeFilename(tt.in); got != tt.want {
+				t.Errorf("sanitizeFilename(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package bundle
+
+import "time"
+
+// Manifest describes every entry the collectors wrote into a bundle
+// archive, so its contents can be verified independently of the KBOM that
+// references them. manifest.json itself, and anything written to the
+// archive after Run returns, are not listed.
+type Manifest struct {
+	GeneratedAt time.Time       `json:"generatedAt"`
+	Entries     []ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry records one file written by a Collector.
+type ManifestEntry struct {
+	Collector string `json:"collector"`
+	File      string `json:"file"`
+	SHA256    string `json:"sha256"`
+}
+
+// recordingArchive wraps an Archive and records every write made through it
+// under a given collector name, so the caller can build a Manifest.
+type recordingArchive struct {
+	collector string
+	archive   Archive
+	entries   chan<- ManifestEntry
+}
+
+func (a *recordingArchive) WriteFile(name string, data []byte) (string, error) {
+	d, err := a.archive.WriteFile(name, data)
+	if err != nil {
+		return "", err
+	}
+
+	a.entries <- ManifestEntry{Collector: a.collector, File: name, SHA256: d}
+
+	return d, nil
+}
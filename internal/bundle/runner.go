@@ -0,0 +1,60 @@
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Run executes every collector concurrently against archive, reporting each
+// collector's status on progress (if non-nil; Run closes it when done), and
+// finally writes a manifest.json entry describing everything that was
+// collected.
+func Run(ctx context.Context, archive Archive, collectors []Collector, progress chan<- Progress) error {
+	if progress != nil {
+		defer close(progress)
+	}
+
+	entries := make(chan ManifestEntry)
+	done := make(chan struct{})
+
+	var manifest Manifest
+	go func() {
+		for e := range entries {
+			manifest.Entries = append(manifest.Entries, e)
+		}
+		close(done)
+	}()
+
+	g, gCtx := errgroup.WithContext(ctx)
+	for _, c := range collectors {
+		c := c
+		g.Go(func() error {
+			err := c.Collect(gCtx, &recordingArchive{collector: c.Name(), archive: archive, entries: entries})
+			if progress != nil {
+				progress <- Progress{Collector: c.Name(), Done: true, Err: err}
+			}
+			return err
+		})
+	}
+
+	err := g.Wait()
+	close(entries)
+	<-done
+
+	if err != nil {
+		return err
+	}
+
+	manifest.GeneratedAt = time.Now()
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = archive.WriteFile("manifest.json", data)
+	return err
+}
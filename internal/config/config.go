@@ -0,0 +1,17 @@
+// Package config holds build-time metadata injected via -ldflags, so a
+// generated KBOM records exactly which kbom build produced it.
+package config
+
+// AppName is the CLI's name, recorded in every generated KBOM's
+// GeneratedBy field.
+var AppName = "kbom"
+
+// AppVersion, BuildTime, LastCommitHash and LastCommitTime are populated at
+// build time via -ldflags "-X github.com/ksoclabs/kbom/internal/config.X=...";
+// they default to "dev"/empty for local `go run`/`go build` without them set.
+var (
+	AppVersion     = "dev"
+	BuildTime      = ""
+	LastCommitHash = ""
+	LastCommitTime = ""
+)
@@ -0,0 +1,132 @@
+package diff
+
+import (
+	"sort"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+// Compute builds a Report describing what changed between from and to.
+func Compute(from, to *model.KBOM) *Report {
+	r := &Report{
+		K8sVersionFrom:   from.Cluster.K8sVersion,
+		K8sVersionTo:     to.Cluster.K8sVersion,
+		CACertDigestFrom: from.Cluster.CACertDigest,
+		CACertDigestTo:   to.Cluster.CACertDigest,
+		Nodes:            diffNodes(from.Cluster.Nodes, to.Cluster.Nodes),
+		Images:           diffImages(from.Cluster.Resources.Images, to.Cluster.Resources.Images),
+		Resources:        diffResources(from.Cluster.Resources.Resources, to.Cluster.Resources.Resources),
+	}
+
+	return r
+}
+
+func diffNodes(from, to []model.Node) NodeDiff {
+	fromSet := make(map[string]struct{}, len(from))
+	for _, n := range from {
+		fromSet[n.Name] = struct{}{}
+	}
+	toSet := make(map[string]struct{}, len(to))
+	for _, n := range to {
+		toSet[n.Name] = struct{}{}
+	}
+
+	var d NodeDiff
+	for name := range toSet {
+		if _, ok := fromSet[name]; !ok {
+			d.Added = append(d.Added, name)
+		}
+	}
+	for name := range fromSet {
+		if _, ok := toSet[name]; !ok {
+			d.Removed = append(d.Removed, name)
+		}
+	}
+
+	sort.Strings(d.Added)
+	sort.Strings(d.Removed)
+
+	return d
+}
+
+func diffImages(from, to []model.Image) ImageDiff {
+	fromSet := make(map[string]struct{}, len(from))
+	for _, img := range from {
+		fromSet[img.Digest] = struct{}{}
+	}
+	toSet := make(map[string]struct{}, len(to))
+	for _, img := range to {
+		toSet[img.Digest] = struct{}{}
+	}
+
+	var d ImageDiff
+	for digest := range toSet {
+		if _, ok := fromSet[digest]; !ok {
+			d.Added = append(d.Added, digest)
+		}
+	}
+	for digest := range fromSet {
+		if _, ok := toSet[digest]; !ok {
+			d.Removed = append(d.Removed, digest)
+		}
+	}
+
+	sort.Strings(d.Added)
+	sort.Strings(d.Removed)
+
+	return d
+}
+
+func diffResources(from, to []model.Resource) ResourceDiff {
+	fromByKey := make(map[ResourceKey]model.Resource, len(from))
+	for _, res := range from {
+		fromByKey[resourceKey(res)] = res
+	}
+	toByKey := make(map[ResourceKey]model.Resource, len(to))
+	for _, res := range to {
+		toByKey[resourceKey(res)] = res
+	}
+
+	var d ResourceDiff
+	for key, res := range toByKey {
+		prev, ok := fromByKey[key]
+		if !ok {
+			d.Added = append(d.Added, key)
+			continue
+		}
+		if prev.UID != res.UID {
+			d.Changed = append(d.Changed, ResourceChange{ResourceKey: key, UIDFrom: prev.UID, UIDTo: res.UID})
+		}
+	}
+	for key := range fromByKey {
+		if _, ok := toByKey[key]; !ok {
+			d.Removed = append(d.Removed, key)
+		}
+	}
+
+	sortResourceKeys(d.Added)
+	sortResourceKeys(d.Removed)
+	sort.Slice(d.Changed, func(i, j int) bool {
+		return resourceKeyLess(d.Changed[i].ResourceKey, d.Changed[j].ResourceKey)
+	})
+
+	return d
+}
+
+func resourceKey(r model.Resource) ResourceKey {
+	return ResourceKey{GVK: r.GVK, Namespace: r.Namespace, Name: r.Name}
+}
+
+func sortResourceKeys(keys []ResourceKey) {
+	sort.Slice(keys, func(i, j int) bool { return resourceKeyLess(keys[i], keys[j]) })
+}
+
+func resourceKeyLess(a, b ResourceKey) bool {
+	if a.GVK != b.GVK {
+		return a.GVK < b.GVK
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,63 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+func TestDiffResources(t *testing.T) {
+	from := []model.Resource{
+		{GVK: "apps/v1, Kind=Deployment", Namespace: "default", Name: "api", UID: "uid-1"},
+		{GVK: "apps/v1, Kind=Deployment", Namespace: "default", Name: "worker", UID: "uid-2"},
+	}
+	to := []model.Resource{
+		{GVK: "apps/v1, Kind=Deployment", Namespace: "default", Name: "api", UID: "uid-1-recreated"},
+		{GVK: "apps/v1, Kind=Deployment", Namespace: "default", Name: "cron", UID: "uid-3"},
+	}
+
+	got := diffResources(from, to)
+
+	wantAdded := []ResourceKey{{GVK: "apps/v1, Kind=Deployment", Namespace: "default", Name: "cron"}}
+	wantRemoved := []ResourceKey{{GVK: "apps/v1, Kind=Deployment", Namespace: "default", Name: "worker"}}
+	wantChanged := []ResourceChange{{
+		ResourceKey: ResourceKey{GVK: "apps/v1, Kind=Deployment", Namespace: "default", Name: "api"},
+		UIDFrom:     "uid-1",
+		UIDTo:       "uid-1-recreated",
+	}}
+
+	if !reflect.DeepEqual(got.Added, wantAdded) {
+		t.Errorf("Added = %+v, want %+v", got.Added, wantAdded)
+	}
+	if !reflect.DeepEqual(got.Removed, wantRemoved) {
+		t.Errorf("Removed = %+v, want %+v", got.Removed, wantRemoved)
+	}
+	if !reflect.DeepEqual(got.Changed, wantChanged) {
+		t.Errorf("Changed = %+v, want %+v", got.Changed, wantChanged)
+	}
+}
+
+func TestComputeVersionDrift(t *testing.T) {
+	from := &model.KBOM{Cluster: model.Cluster{K8sVersion: "v1.28.0", CACertDigest: "abc"}}
+	to := &model.KBOM{Cluster: model.Cluster{K8sVersion: "v1.29.0", CACertDigest: "abc"}}
+
+	report := Compute(from, to)
+
+	if !report.VersionDrift() {
+		t.Error("VersionDrift() = false, want true for differing K8sVersion")
+	}
+	if report.Empty() {
+		t.Error("Empty() = true, want false when versions differ")
+	}
+}
+
+func TestComputeEmpty(t *testing.T) {
+	kbom := &model.KBOM{Cluster: model.Cluster{K8sVersion: "v1.28.0", CACertDigest: "abc"}}
+
+	report := Compute(kbom, kbom)
+
+	if !report.Empty() {
+		t.Error("Empty() = false, want true when nothing changed")
+	}
+}
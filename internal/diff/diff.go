@@ -0,0 +1,71 @@
+// Package diff compares two model.KBOM captures of the same (or different)
+// clusters and reports what changed, so `kbom diff` can be wired into CI to
+// fail when unexpected images or workloads appear between two captures.
+package diff
+
+// Report is the structured result of comparing two KBOMs.
+type Report struct {
+	K8sVersionFrom   string `json:"k8sVersionFrom"`
+	K8sVersionTo     string `json:"k8sVersionTo"`
+	CACertDigestFrom string `json:"caCertDigestFrom"`
+	CACertDigestTo   string `json:"caCertDigestTo"`
+
+	Nodes     NodeDiff     `json:"nodes"`
+	Images    ImageDiff    `json:"images"`
+	Resources ResourceDiff `json:"resources"`
+}
+
+// VersionDrift reports whether the cluster's K8s version or CA certificate
+// changed between the two captures.
+func (r *Report) VersionDrift() bool {
+	return r.K8sVersionFrom != r.K8sVersionTo || r.CACertDigestFrom != r.CACertDigestTo
+}
+
+// Empty reports whether nothing changed between the two captures.
+func (r *Report) Empty() bool {
+	return !r.VersionDrift() && r.Nodes.Empty() && r.Images.Empty() && r.Resources.Empty()
+}
+
+// NodeDiff lists nodes added/removed between two captures, keyed by name.
+type NodeDiff struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+func (d NodeDiff) Empty() bool { return len(d.Added) == 0 && len(d.Removed) == 0 }
+
+// ImageDiff lists images added/removed between two captures, keyed by
+// digest.
+type ImageDiff struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+func (d ImageDiff) Empty() bool { return len(d.Added) == 0 && len(d.Removed) == 0 }
+
+// ResourceKey identifies a Kubernetes resource by GVK, namespace and name.
+type ResourceKey struct {
+	GVK       string `json:"gvk"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// ResourceChange is a resource present in both captures but recreated
+// (its UID changed) between them.
+type ResourceChange struct {
+	ResourceKey
+	UIDFrom string `json:"uidFrom"`
+	UIDTo   string `json:"uidTo"`
+}
+
+// ResourceDiff lists resources added, removed, or recreated between two
+// captures, keyed by GVK+namespace+name.
+type ResourceDiff struct {
+	Added   []ResourceKey    `json:"added,omitempty"`
+	Removed []ResourceKey    `json:"removed,omitempty"`
+	Changed []ResourceChange `json:"changed,omitempty"`
+}
+
+func (d ResourceDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
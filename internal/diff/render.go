@@ -0,0 +1,114 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+const (
+	colorGreen = "\033[32m"
+	colorRed   = "\033[31m"
+	colorReset = "\033[0m"
+)
+
+// RenderText writes a colorized, tabwriter-aligned summary of the report:
+// green `+` lines for additions, red `-` lines for removals.
+func RenderText(w io.Writer, r *Report) error {
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+
+	if r.VersionDrift() {
+		fmt.Fprintf(tw, "k8s version\t%s\t->\t%s\n", r.K8sVersionFrom, r.K8sVersionTo)
+		fmt.Fprintf(tw, "CA cert digest\t%s\t->\t%s\n", r.CACertDigestFrom, r.CACertDigestTo)
+	}
+
+	for _, n := range r.Nodes.Added {
+		fmt.Fprintf(tw, "%s+ node\t%s%s\n", colorGreen, n, colorReset)
+	}
+	for _, n := range r.Nodes.Removed {
+		fmt.Fprintf(tw, "%s- node\t%s%s\n", colorRed, n, colorReset)
+	}
+
+	for _, img := range r.Images.Added {
+		fmt.Fprintf(tw, "%s+ image\t%s%s\n", colorGreen, img, colorReset)
+	}
+	for _, img := range r.Images.Removed {
+		fmt.Fprintf(tw, "%s- image\t%s%s\n", colorRed, img, colorReset)
+	}
+
+	for _, res := range r.Resources.Added {
+		fmt.Fprintf(tw, "%s+ resource\t%s\t%s/%s%s\n", colorGreen, res.GVK, res.Namespace, res.Name, colorReset)
+	}
+	for _, res := range r.Resources.Removed {
+		fmt.Fprintf(tw, "%s- resource\t%s\t%s/%s%s\n", colorRed, res.GVK, res.Namespace, res.Name, colorReset)
+	}
+	for _, res := range r.Resources.Changed {
+		fmt.Fprintf(tw, "~ resource\t%s\t%s/%s\trecreated (%s -> %s)\n", res.GVK, res.Namespace, res.Name, res.UIDFrom, res.UIDTo)
+	}
+
+	if r.Empty() {
+		fmt.Fprintln(tw, "no differences")
+	}
+
+	return tw.Flush()
+}
+
+// RenderJSON writes the report as indented JSON.
+func RenderJSON(w io.Writer, r *Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// RenderMarkdown writes the report as a Markdown summary suitable for a PR
+// comment.
+func RenderMarkdown(w io.Writer, r *Report) error {
+	if r.Empty() {
+		_, err := fmt.Fprintln(w, "No differences between KBOMs.")
+		return err
+	}
+
+	fmt.Fprintln(w, "## KBOM diff")
+
+	if r.VersionDrift() {
+		fmt.Fprintln(w, "\n### Cluster")
+		fmt.Fprintf(w, "- K8s version: `%s` -> `%s`\n", r.K8sVersionFrom, r.K8sVersionTo)
+		fmt.Fprintf(w, "- CA cert digest: `%s` -> `%s`\n", r.CACertDigestFrom, r.CACertDigestTo)
+	}
+
+	if !r.Nodes.Empty() {
+		fmt.Fprintln(w, "\n### Nodes")
+		for _, n := range r.Nodes.Added {
+			fmt.Fprintf(w, "- :heavy_plus_sign: `%s`\n", n)
+		}
+		for _, n := range r.Nodes.Removed {
+			fmt.Fprintf(w, "- :heavy_minus_sign: `%s`\n", n)
+		}
+	}
+
+	if !r.Images.Empty() {
+		fmt.Fprintln(w, "\n### Images")
+		for _, img := range r.Images.Added {
+			fmt.Fprintf(w, "- :heavy_plus_sign: `%s`\n", img)
+		}
+		for _, img := range r.Images.Removed {
+			fmt.Fprintf(w, "- :heavy_minus_sign: `%s`\n", img)
+		}
+	}
+
+	if !r.Resources.Empty() {
+		fmt.Fprintln(w, "\n### Resources")
+		for _, res := range r.Resources.Added {
+			fmt.Fprintf(w, "- :heavy_plus_sign: `%s` %s/%s\n", res.GVK, res.Namespace, res.Name)
+		}
+		for _, res := range r.Resources.Removed {
+			fmt.Fprintf(w, "- :heavy_minus_sign: `%s` %s/%s\n", res.GVK, res.Namespace, res.Name)
+		}
+		for _, res := range r.Resources.Changed {
+			fmt.Fprintf(w, "- :recycle: `%s` %s/%s recreated\n", res.GVK, res.Namespace, res.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package format
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+const cycloneDXSpecVersion = "1.5"
+
+func init() {
+	Register("cyclonedx-json", cycloneDXJSONFormatter{})
+	Register("cyclonedx-xml", cycloneDXXMLFormatter{})
+}
+
+// cdxBOM is a minimal CycloneDX 1.5 bill-of-materials covering the fields a
+// KBOM can populate: cluster nodes and container images as components, and
+// Kubernetes resources as bom-ref-linked components keyed by a k8s PURL.
+type cdxBOM struct {
+	XMLName      xml.Name       `json:"-" xml:"bom"`
+	XMLNS        string         `json:"-" xml:"xmlns,attr"`
+	BOMFormat    string         `json:"bomFormat" xml:"-"`
+	SpecVersion  string         `json:"specVersion" xml:"specVersion,attr"`
+	SerialNumber string         `json:"serialNumber" xml:"serialNumber,attr"`
+	Version      int            `json:"version" xml:"version,attr"`
+	Components   []cdxComponent `json:"components" xml:"components>component"`
+}
+
+type cdxComponent struct {
+	BOMRef     string    `json:"bom-ref" xml:"bom-ref,attr"`
+	Type       string    `json:"type" xml:"type,attr"`
+	Name       string    `json:"name" xml:"name"`
+	Version    string    `json:"version,omitempty" xml:"version,omitempty"`
+	PackageURL string    `json:"purl,omitempty" xml:"purl,omitempty"`
+	Hashes     []cdxHash `json:"hashes,omitempty" xml:"hashes>hash,omitempty"`
+}
+
+type cdxHash struct {
+	Algorithm string `json:"alg" xml:"alg,attr"`
+	Content   string `json:"content" xml:",chardata"`
+}
+
+func toCycloneDX(kbom *model.KBOM) *cdxBOM {
+	bom := &cdxBOM{
+		XMLNS:        "http://cyclonedx.org/schema/bom/1.5",
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  cycloneDXSpecVersion,
+		SerialNumber: fmt.Sprintf("urn:uuid:%s", kbom.ID),
+		Version:      1,
+	}
+
+	for _, n := range kbom.Cluster.Nodes {
+		bom.Components = append(bom.Components, cdxComponent{
+			BOMRef:  fmt.Sprintf("node:%s", n.Name),
+			Type:    "platform",
+			Name:    n.Name,
+			Version: n.KubeletVersion,
+		})
+	}
+
+	for _, img := range kbom.Cluster.Resources.Images {
+		c := cdxComponent{
+			BOMRef: fmt.Sprintf("image:%s", img.Name),
+			Type:   "container",
+			Name:   img.Name,
+		}
+		if img.Digest != "" {
+			c.Hashes = []cdxHash{{Algorithm: "SHA-256", Content: img.Digest}}
+		}
+		bom.Components = append(bom.Components, c)
+	}
+
+	for _, r := range kbom.Cluster.Resources.Resources {
+		bom.Components = append(bom.Components, cdxComponent{
+			BOMRef:     fmt.Sprintf("resource:%s", r.UID),
+			Type:       "data",
+			Name:       fmt.Sprintf("%s/%s", r.Namespace, r.Name),
+			PackageURL: gvkToPURL(r.GVK),
+		})
+	}
+
+	return bom
+}
+
+// gvkToPURL encodes a "group/version, Kind=kind" GVK string as a
+// pkg:k8s/<group>/<kind>@<version> PURL, e.g. "apps/v1, Kind=Deployment" ->
+// "pkg:k8s/apps/Deployment@v1". Core-group resources (Pod, Service, …) carry
+// no group segment in their GVK string, so the group/ prefix is omitted
+// rather than left as an empty segment: "pkg:k8s/Pod@v1", not
+// "pkg:k8s//Pod@v1".
+func gvkToPURL(gvk string) string {
+	group, version, kind := splitGVK(gvk)
+	if group == "" {
+		return fmt.Sprintf("pkg:k8s/%s@%s", kind, version)
+	}
+	return fmt.Sprintf("pkg:k8s/%s/%s@%s", group, kind, version)
+}
+
+type cycloneDXJSONFormatter struct{}
+
+func (cycloneDXJSONFormatter) Encode(w io.Writer, kbom *model.KBOM) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(toCycloneDX(kbom))
+}
+
+type cycloneDXXMLFormatter struct{}
+
+func (cycloneDXXMLFormatter) Encode(w io.Writer, kbom *model.KBOM) error {
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(toCycloneDX(kbom))
+}
@@ -0,0 +1,44 @@
+// Package format maps a model.KBOM onto the on-disk representations
+// `kbom generate --format` can emit: the proprietary ksoc shape plus
+// standard SBOM schemas (CycloneDX, SPDX) that downstream tools like
+// Grype, Trivy, Dependency-Track and GUAC already know how to ingest.
+package format
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+// Formatter encodes a KBOM into a specific representation.
+type Formatter interface {
+	Encode(w io.Writer, kbom *model.KBOM) error
+}
+
+var registry = map[string]Formatter{}
+
+// Register makes a Formatter available under name. Called from each
+// implementation's init(), mirroring the stdlib's database/sql driver
+// pattern. It panics on duplicate registration.
+func Register(name string, f Formatter) {
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("format: Register called twice for format %q", name))
+	}
+	registry[name] = f
+}
+
+// Get returns the Formatter registered under name.
+func Get(name string) (Formatter, bool) {
+	f, ok := registry[name]
+	return f, ok
+}
+
+// Names returns every registered format name.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for n := range registry {
+		names = append(names, n)
+	}
+	return names
+}
@@ -0,0 +1,23 @@
+package format
+
+import "strings"
+
+// splitGVK parses the GVK strings KBOM resources carry, e.g.
+// "apps/v1, Kind=Deployment", into their group, version and kind parts.
+// Core resources have an empty group: GroupVersionKind().String() renders
+// them as "/v1, Kind=Pod", and the form without a "/" ("v1, Kind=Pod") is
+// accepted too. Unparseable input is returned as the kind with an empty
+// group/version so callers still get a usable PURL.
+func splitGVK(gvk string) (group, version, kind string) {
+	gv, k, ok := strings.Cut(gvk, ", Kind=")
+	if !ok {
+		return "", "", gvk
+	}
+	kind = k
+
+	if g, v, ok := strings.Cut(gv, "/"); ok {
+		return g, v, kind
+	}
+
+	return "", gv, kind
+}
@@ -0,0 +1,46 @@
+package format
+
+import "testing"
+
+func TestSplitGVK(t *testing.T) {
+	tests := []struct {
+		name        string
+		gvk         string
+		wantGroup   string
+		wantVersion string
+		wantKind    string
+	}{
+		{"namespaced group", "apps/v1, Kind=Deployment", "apps", "v1", "Deployment"},
+		{"core group", "v1, Kind=Pod", "", "v1", "Pod"},
+		{"unparseable", "garbage", "", "", "garbage"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, version, kind := splitGVK(tt.gvk)
+			if group != tt.wantGroup || version != tt.wantVersion || kind != tt.wantKind {
+				t.Errorf("splitGVK(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					tt.gvk, group, version, kind, tt.wantGroup, tt.wantVersion, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestGVKToPURL(t *testing.T) {
+	tests := []struct {
+		name string
+		gvk  string
+		want string
+	}{
+		{"namespaced group", "apps/v1, Kind=Deployment", "pkg:k8s/apps/Deployment@v1"},
+		{"core group", "v1, Kind=Pod", "pkg:k8s/Pod@v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gvkToPURL(tt.gvk); got != tt.want {
+				t.Errorf("gvkToPURL(%q) = %q, want %q", tt.gvk, got, tt.want)
+			}
+		})
+	}
+}
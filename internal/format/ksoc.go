@@ -0,0 +1,31 @@
+package format
+
+import (
+	"encoding/json"
+	"io"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+func init() {
+	Register("json", jsonFormatter{})
+	Register("yaml", yamlFormatter{})
+}
+
+// jsonFormatter encodes the proprietary ksoc KBOM shape as JSON.
+type jsonFormatter struct{}
+
+func (jsonFormatter) Encode(w io.Writer, kbom *model.KBOM) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(kbom)
+}
+
+// yamlFormatter encodes the proprietary ksoc KBOM shape as YAML.
+type yamlFormatter struct{}
+
+func (yamlFormatter) Encode(w io.Writer, kbom *model.KBOM) error {
+	return yaml.NewEncoder(w).Encode(kbom)
+}
@@ -0,0 +1,146 @@
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+const spdxVersion = "SPDX-2.3"
+
+func init() {
+	Register("spdx-json", spdxJSONFormatter{})
+}
+
+// spdxDocument is a minimal SPDX 2.3 document: one package per cluster node
+// and container image, plus one per Kubernetes resource, each CONTAINed by
+// a top-level cluster package.
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+const clusterPackageID = "SPDXRef-cluster"
+
+func toSPDX(kbom *model.KBOM) *spdxDocument {
+	doc := &spdxDocument{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              fmt.Sprintf("kbom-%s", kbom.ID),
+		DocumentNamespace: fmt.Sprintf("https://ksoc.com/kbom/%s", kbom.ID),
+		Packages: []spdxPackage{{
+			SPDXID:           clusterPackageID,
+			Name:             "cluster",
+			VersionInfo:      kbom.Cluster.K8sVersion,
+			DownloadLocation: "NOASSERTION",
+		}},
+	}
+
+	addContains := func(id string) {
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      clusterPackageID,
+			RelationshipType:   "CONTAINS",
+			RelatedSPDXElement: id,
+		})
+	}
+
+	for _, n := range kbom.Cluster.Nodes {
+		id := fmt.Sprintf("SPDXRef-node-%s", sanitizeSPDXID(n.Name))
+		doc.Packages = append(doc.Packages, spdxPackage{
+			SPDXID:           id,
+			Name:             n.Name,
+			VersionInfo:      n.KubeletVersion,
+			DownloadLocation: "NOASSERTION",
+		})
+		addContains(id)
+	}
+
+	for _, img := range kbom.Cluster.Resources.Images {
+		id := fmt.Sprintf("SPDXRef-image-%s", sanitizeSPDXID(img.Name))
+		pkg := spdxPackage{
+			SPDXID:           id,
+			Name:             img.Name,
+			DownloadLocation: "NOASSERTION",
+		}
+		if img.Digest != "" {
+			pkg.ExternalRefs = []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  fmt.Sprintf("pkg:oci/%s@%s", img.Name, img.Digest),
+			}}
+		}
+		doc.Packages = append(doc.Packages, pkg)
+		addContains(id)
+	}
+
+	for _, r := range kbom.Cluster.Resources.Resources {
+		id := fmt.Sprintf("SPDXRef-resource-%s", sanitizeSPDXID(r.UID))
+		_, version, _ := splitGVK(r.GVK)
+		doc.Packages = append(doc.Packages, spdxPackage{
+			SPDXID:           id,
+			Name:             fmt.Sprintf("%s/%s", r.Namespace, r.Name),
+			VersionInfo:      version,
+			DownloadLocation: "NOASSERTION",
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  gvkToPURL(r.GVK),
+			}},
+		})
+		addContains(id)
+	}
+
+	return doc
+}
+
+// sanitizeSPDXID replaces each character SPDX identifiers don't allow with
+// '-' (only letters, digits and '-' or '.' are valid after the SPDXRef-
+// prefix).
+func sanitizeSPDXID(s string) string {
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			out = append(out, r)
+		default:
+			out = append(out, '-')
+		}
+	}
+	return string(out)
+}
+
+type spdxJSONFormatter struct{}
+
+func (spdxJSONFormatter) Encode(w io.Writer, kbom *model.KBOM) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(toSPDX(kbom))
+}
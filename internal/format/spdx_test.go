@@ -0,0 +1,23 @@
+package format
+
+import "testing"
+
+func TestSanitizeSPDXID(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"already safe", "node-1", "node-1"},
+		{"uid with colons", "a1b2:c3d4", "a1b2-c3d4"},
+		{"image ref with slashes", "repo/name:tag", "repo-name-tag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeSPDXID(tt.in); got != tt.want {
+				t.Errorf("sanitizeSPDXID(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package kube
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// NodeObjects returns the raw node objects, for a support bundle to archive
+// as evidence alongside the summarized KBOM.
+func (c *client) NodeObjects(ctx context.Context) ([]corev1.Node, error) {
+	list, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// ResourcesByGVK returns the raw objects of every listable resource kind,
+// keyed by "group/version, Kind=Kind" GVK string, for a support bundle to
+// archive as evidence.
+func (c *client) ResourcesByGVK(ctx context.Context) (map[string][]unstructured.Unstructured, error) {
+	gvrs, err := c.listableGVRs()
+	if err != nil {
+		return nil, err
+	}
+
+	byGVK := map[string][]unstructured.Unstructured{}
+	for _, gvr := range gvrs {
+		list, err := c.dynamic.Resource(gvr).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			continue
+		}
+
+		for _, item := range list.Items {
+			gvk := item.GroupVersionKind().String()
+			byGVK[gvk] = append(byGVK[gvk], item)
+		}
+	}
+
+	return byGVK, nil
+}
+
+// APIResourceList returns the server's advertised API resources, for a
+// support bundle to record what the cluster's discovery looked like at
+// generation time.
+func (c *client) APIResourceList(_ context.Context) ([]*metav1.APIResourceList, error) {
+	list, err := c.discovery.ServerPreferredResources()
+	if err != nil && len(list) == 0 {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,24 @@
+package kube
+
+import (
+	"sort"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Contexts returns every context name defined in the kubeconfig, sorted,
+// for use with `generate --all-contexts`.
+func Contexts() ([]string, error) {
+	raw, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(raw.Contexts))
+	for name := range raw.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
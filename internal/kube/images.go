@@ -0,0 +1,52 @@
+package kube
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+// AllImages returns the deduplicated set of container images reported by
+// every node's status.
+func (c *client) AllImages(ctx context.Context) ([]model.Image, error) {
+	nodes, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]model.Image{}
+	for _, node := range nodes.Items {
+		for _, img := range node.Status.Images {
+			for _, ref := range img.Names {
+				seen[ref] = model.Image{Name: imageName(ref), Digest: imageDigest(ref)}
+			}
+		}
+	}
+
+	images := make([]model.Image, 0, len(seen))
+	for _, img := range seen {
+		images = append(images, img)
+	}
+	sort.Slice(images, func(i, j int) bool { return images[i].Name < images[j].Name })
+
+	return images, nil
+}
+
+// imageName and imageDigest split a node-reported image reference
+// ("repo/name@sha256:...") into its name and digest parts.
+func imageName(ref string) string {
+	name, _, _ := strings.Cut(ref, "@")
+	return name
+}
+
+func imageDigest(ref string) string {
+	_, digest, ok := strings.Cut(ref, "@")
+	if !ok {
+		return ""
+	}
+	return digest
+}
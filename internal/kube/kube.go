@@ -0,0 +1,88 @@
+// Package kube talks to Kubernetes clusters on behalf of `kbom generate`:
+// cluster metadata, nodes, container images, the full resource inventory,
+// and the raw objects a support bundle archives as evidence.
+package kube
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+// Client talks to a single Kubernetes cluster.
+type Client interface {
+	Metadata(ctx context.Context) (k8sVersion, caCertDigest string, err error)
+	Location(ctx context.Context) (*model.Location, error)
+	AllNodes(ctx context.Context, full bool) ([]model.Node, error)
+	AllImages(ctx context.Context) ([]model.Image, error)
+	AllResources(ctx context.Context, full bool) ([]model.Resource, error)
+
+	// NodeObjects, ResourcesByGVK and APIResourceList return raw
+	// evidence for `generate --output=bundle` rather than the
+	// summarized model types above.
+	NodeObjects(ctx context.Context) ([]corev1.Node, error)
+	ResourcesByGVK(ctx context.Context) (map[string][]unstructured.Unstructured, error)
+	APIResourceList(ctx context.Context) ([]*metav1.APIResourceList, error)
+}
+
+// client is the default Client implementation, backed by client-go.
+type client struct {
+	restConfig *rest.Config
+	clientset  *kubernetes.Clientset
+	dynamic    dynamic.Interface
+	discovery  discovery.DiscoveryInterface
+}
+
+// NewClient builds a Client for kubeContext. An empty kubeContext uses the
+// kubeconfig's current-context, matching the pre-multi-cluster behavior.
+func NewClient(kubeContext string) (Client, error) {
+	restConfig, err := loadConfig(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		restConfig: restConfig,
+		clientset:  clientset,
+		dynamic:    dynamicClient,
+		discovery:  discoveryClient,
+	}, nil
+}
+
+// loadConfig resolves a *rest.Config from the default kubeconfig loading
+// rules (KUBECONFIG, then ~/.kube/config), overriding the current context
+// when kubeContext is non-empty.
+func loadConfig(kubeContext string) (*rest.Config, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+
+	overrides := &clientcmd.ConfigOverrides{}
+	if kubeContext != "" {
+		overrides.CurrentContext = kubeContext
+	}
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+}
@@ -0,0 +1,57 @@
+package kube
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+func (c *client) Metadata(_ context.Context) (string, string, error) {
+	version, err := c.discovery.ServerVersion()
+	if err != nil {
+		return "", "", err
+	}
+
+	return version.GitVersion, caCertDigest(c.restConfig.CAData), nil
+}
+
+func (c *client) Location(ctx context.Context) (*model.Location, error) {
+	nodes, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	loc := &model.Location{}
+	if len(nodes.Items) == 0 {
+		return loc, nil
+	}
+
+	node := nodes.Items[0]
+	loc.Cloud = cloudFromProviderID(node.Spec.ProviderID)
+	loc.Region = node.Labels["topology.kubernetes.io/region"]
+
+	return loc, nil
+}
+
+// cloudFromProviderID extracts the cloud provider name from a node's
+// providerID, e.g. "aws:///us-west-2a/i-0123" -> "aws".
+func cloudFromProviderID(providerID string) string {
+	cloud, _, ok := strings.Cut(providerID, "://")
+	if !ok {
+		return ""
+	}
+	return cloud
+}
+
+func caCertDigest(caData []byte) string {
+	if len(caData) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(caData)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,34 @@
+package kube
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+func (c *client) AllNodes(ctx context.Context, _ bool) ([]model.Node, error) {
+	list, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]model.Node, 0, len(list.Items))
+	for _, n := range list.Items {
+		nodes = append(nodes, nodeFromObject(n))
+	}
+
+	return nodes, nil
+}
+
+func nodeFromObject(n corev1.Node) model.Node {
+	return model.Node{
+		Name:                    n.Name,
+		KubeletVersion:          n.Status.NodeInfo.KubeletVersion,
+		OSImage:                 n.Status.NodeInfo.OSImage,
+		Architecture:            n.Status.NodeInfo.Architecture,
+		ContainerRuntimeVersion: n.Status.NodeInfo.ContainerRuntimeVersion,
+	}
+}
@@ -0,0 +1,73 @@
+package kube
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/ksoclabs/kbom/internal/model"
+)
+
+// AllResources lists every listable, namespaced-or-cluster-scoped resource
+// the server's discovery advertises.
+func (c *client) AllResources(ctx context.Context, _ bool) ([]model.Resource, error) {
+	gvrs, err := c.listableGVRs()
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []model.Resource
+	for _, gvr := range gvrs {
+		list, err := c.dynamic.Resource(gvr).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			// Best-effort: RBAC commonly blocks a handful of resource
+			// kinds without making the whole inventory unusable.
+			continue
+		}
+
+		for _, item := range list.Items {
+			resources = append(resources, model.Resource{
+				GVK:       item.GroupVersionKind().String(),
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+				UID:       string(item.GetUID()),
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+func (c *client) listableGVRs() ([]schema.GroupVersionResource, error) {
+	apiResourceLists, err := c.discovery.ServerPreferredResources()
+	if err != nil && len(apiResourceLists) == 0 {
+		return nil, err
+	}
+
+	var gvrs []schema.GroupVersionResource
+	for _, list := range apiResourceLists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, res := range list.APIResources {
+			if !isListable(res) {
+				continue
+			}
+			gvrs = append(gvrs, gv.WithResource(res.Name))
+		}
+	}
+
+	return gvrs, nil
+}
+
+func isListable(res metav1.APIResource) bool {
+	for _, verb := range res.Verbs {
+		if verb == "list" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// AggregateKBOM summarizes the KBOMs generated for a fleet of clusters in a
+// single `generate --contexts`/`--all-contexts` invocation.
+type AggregateKBOM struct {
+	ID          string       `json:"id" yaml:"id"`
+	BOMFormat   string       `json:"bomFormat" yaml:"bomFormat"`
+	SpecVersion string       `json:"specVersion" yaml:"specVersion"`
+	GeneratedAt time.Time    `json:"generatedAt" yaml:"generatedAt"`
+	GeneratedBy Tool         `json:"generatedBy" yaml:"generatedBy"`
+	Clusters    []ClusterRef `json:"clusters" yaml:"clusters"`
+}
+
+// ClusterRef points at one cluster's KBOM within an AggregateKBOM.
+type ClusterRef struct {
+	Context        string `json:"context" yaml:"context"`
+	ID             string `json:"id" yaml:"id"`
+	K8sVersion     string `json:"k8sVersion" yaml:"k8sVersion"`
+	CACertDigest   string `json:"caCertDigest" yaml:"caCertDigest"`
+	NodesCount     int    `json:"nodesCount" yaml:"nodesCount"`
+	ResourcesCount int    `json:"resourcesCount" yaml:"resourcesCount"`
+	ImagesCount    int    `json:"imagesCount" yaml:"imagesCount"`
+	Path           string `json:"path,omitempty" yaml:"path,omitempty"`
+}
@@ -0,0 +1,72 @@
+package model
+
+import "time"
+
+// KBOM is a snapshot of a single Kubernetes cluster's nodes, container
+// images and resource inventory, plus the metadata needed to identify which
+// cluster and tool version produced it.
+type KBOM struct {
+	ID          string    `json:"id" yaml:"id"`
+	BOMFormat   string    `json:"bomFormat" yaml:"bomFormat"`
+	SpecVersion string    `json:"specVersion" yaml:"specVersion"`
+	GeneratedAt time.Time `json:"generatedAt" yaml:"generatedAt"`
+	GeneratedBy Tool      `json:"generatedBy" yaml:"generatedBy"`
+	Cluster     Cluster   `json:"cluster" yaml:"cluster"`
+}
+
+// Tool identifies the kbom build that generated a KBOM.
+type Tool struct {
+	Vendor     string `json:"vendor" yaml:"vendor"`
+	Name       string `json:"name" yaml:"name"`
+	Version    string `json:"version" yaml:"version"`
+	BuildTime  string `json:"buildTime" yaml:"buildTime"`
+	Commit     string `json:"commit" yaml:"commit"`
+	CommitTime string `json:"commitTime" yaml:"commitTime"`
+}
+
+// Location describes where a cluster runs.
+type Location struct {
+	Cloud  string `json:"cloud" yaml:"cloud"`
+	Region string `json:"region" yaml:"region"`
+}
+
+// Cluster is the cluster-level content of a KBOM.
+type Cluster struct {
+	Location     Location  `json:"location" yaml:"location"`
+	CNIVersion   string    `json:"cniVersion" yaml:"cniVersion"`
+	K8sVersion   string    `json:"k8sVersion" yaml:"k8sVersion"`
+	CACertDigest string    `json:"caCertDigest" yaml:"caCertDigest"`
+	NodesCount   int       `json:"nodesCount" yaml:"nodesCount"`
+	Nodes        []Node    `json:"nodes" yaml:"nodes"`
+	Resources    Resources `json:"resources" yaml:"resources"`
+}
+
+// Node is a single cluster node.
+type Node struct {
+	Name                    string `json:"name" yaml:"name"`
+	KubeletVersion          string `json:"kubeletVersion" yaml:"kubeletVersion"`
+	OSImage                 string `json:"osImage" yaml:"osImage"`
+	Architecture            string `json:"architecture" yaml:"architecture"`
+	ContainerRuntimeVersion string `json:"containerRuntimeVersion" yaml:"containerRuntimeVersion"`
+}
+
+// Image is a single container image discovered running in the cluster.
+type Image struct {
+	Name   string `json:"name" yaml:"name"`
+	Digest string `json:"digest" yaml:"digest"`
+}
+
+// Resource is a single Kubernetes API object in the cluster's resource
+// inventory.
+type Resource struct {
+	GVK       string `json:"gvk" yaml:"gvk"`
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Name      string `json:"name" yaml:"name"`
+	UID       string `json:"uid" yaml:"uid"`
+}
+
+// Resources groups the images and API objects found in a cluster.
+type Resources struct {
+	Images    []Image    `json:"images" yaml:"images"`
+	Resources []Resource `json:"resources" yaml:"resources"`
+}
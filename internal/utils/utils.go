@@ -0,0 +1,30 @@
+// Package utils holds small helpers shared across cmd's cobra commands.
+package utils
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// EnvPrefix is the prefix kbom's flags can also be set through, e.g.
+// --out-path can be set via KBOM_OUT_PATH.
+const EnvPrefix = "KBOM"
+
+// BindFlags binds every flag on cmd to viper, so each can also be set via a
+// KBOM_-prefixed environment variable, and applies any value already set
+// that way as the flag's default.
+func BindFlags(cmd *cobra.Command) {
+	v := viper.New()
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if !f.Changed && v.IsSet(f.Name) {
+			_ = cmd.Flags().Set(f.Name, v.GetString(f.Name))
+		}
+	})
+}